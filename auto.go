@@ -0,0 +1,71 @@
+package verushash
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Serialized Verus block header layout constants used to locate the
+// embedded solution version without fully parsing the header.
+const (
+	// solutionSize is CConstVerusSolutionVector::SOLUTION_SIZE, the
+	// length of the nSolution payload — not the total serialized header
+	// length, which also includes the preceding fixed fields and a
+	// CompactSize varint and varies by version.
+	solutionSize = 1344
+	// solutionVersionOffset is the byte offset of the nSolution prefix
+	// that encodes the solution version.
+	solutionVersionOffset = 143
+	// solutionVersionLength is the width, in bytes, of the solution
+	// version field read from solutionVersionOffset.
+	solutionVersionLength = 4
+)
+
+// Solution version values, per the Verus consensus rule that selects the
+// hashing algorithm for a given header.
+const (
+	solutionVersionV1  uint32 = 1
+	solutionVersionV2  uint32 = 0x0200
+	solutionVersionV21 uint32 = 0x0201
+	solutionVersionV22 uint32 = 0x0202
+)
+
+// VerusHashAuto parses the solution version out of a serialized header's
+// nSolution prefix and dispatches to the matching VerusHash variant,
+// mirroring the Verus consensus rule: v1 -> VerusHash, v2.0 -> V2B,
+// v2.1 -> V2B1, v2.2+ -> V2B2. It returns an error for unrecognized
+// versions rather than silently falling back to a default variant.
+//
+// It only requires enough bytes to contain the solution version field
+// and does not check the total header length. The field is read at the
+// fixed solutionVersionOffset, which is where it lies when the 140 bytes
+// of fixed header fields are followed by the 3-byte CompactSize length
+// of a solutionSize-byte nSolution.
+func VerusHashAuto(header []byte) ([]byte, error) {
+	if len(header) < solutionVersionOffset+solutionVersionLength {
+		return nil, fmt.Errorf("verushash: header too short to contain a solution version: need %d bytes, got %d", solutionVersionOffset+solutionVersionLength, len(header))
+	}
+	version := binary.LittleEndian.Uint32(header[solutionVersionOffset : solutionVersionOffset+solutionVersionLength])
+	variant, err := variantForSolutionVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	return hashFor(variant, header), nil
+}
+
+// variantForSolutionVersion maps a raw solution version to the VerusHash
+// variant the consensus rules require for it.
+func variantForSolutionVersion(version uint32) (Variant, error) {
+	switch {
+	case version == solutionVersionV1:
+		return V1, nil
+	case version == solutionVersionV2:
+		return V2B, nil
+	case version == solutionVersionV21:
+		return V2B1, nil
+	case version >= solutionVersionV22:
+		return V2B2, nil
+	default:
+		return 0, fmt.Errorf("verushash: unrecognized solution version %#x", version)
+	}
+}
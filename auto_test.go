@@ -0,0 +1,53 @@
+package verushash
+
+import "testing"
+
+func TestVariantForSolutionVersion(t *testing.T) {
+	cases := []struct {
+		name    string
+		version uint32
+		want    Variant
+		wantErr bool
+	}{
+		{"v1", solutionVersionV1, V1, false},
+		{"v2.0", solutionVersionV2, V2B, false},
+		{"v2.1", solutionVersionV21, V2B1, false},
+		{"v2.2", solutionVersionV22, V2B2, false},
+		{"above v2.2 still maps to V2B2", solutionVersionV22 + 1, V2B2, false},
+		{"unrecognized version", 0, 0, true},
+		{"between v1 and v2.0", solutionVersionV1 + 1, 0, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := variantForSolutionVersion(c.version)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("variantForSolutionVersion(%#x) returned nil error, want one", c.version)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("variantForSolutionVersion(%#x) returned unexpected error: %v", c.version, err)
+			}
+			if got != c.want {
+				t.Fatalf("variantForSolutionVersion(%#x) = %v, want %v", c.version, got, c.want)
+			}
+		})
+	}
+}
+
+func TestVerusHashAutoRejectsShortHeader(t *testing.T) {
+	header := make([]byte, solutionVersionOffset+solutionVersionLength-1)
+	if _, err := VerusHashAuto(header); err == nil {
+		t.Fatalf("VerusHashAuto with a too-short header returned nil error, want one")
+	}
+}
+
+func TestVerusHashAutoRejectsUnrecognizedVersion(t *testing.T) {
+	header := make([]byte, solutionVersionOffset+solutionVersionLength)
+	// Leave the solution version field as zero, which is not a
+	// recognized solution version.
+	if _, err := VerusHashAuto(header); err == nil {
+		t.Fatalf("VerusHashAuto with an unrecognized solution version returned nil error, want one")
+	}
+}
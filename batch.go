@@ -0,0 +1,101 @@
+// +build !purego
+
+package verushash
+
+import (
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/hashpool/go-verushash/verushash"
+)
+
+// Hasher owns a pool of VH.Verushash instances, one per worker goroutine,
+// so that concurrent callers no longer serialize through the single
+// package-level verusHash instance used by VerusHash/VerusHash_V2B*. Each
+// worker owns its instance exclusively, so there is no data race across
+// cgo calls.
+type Hasher struct {
+	jobs chan batchJob
+	wg   sync.WaitGroup
+}
+
+type batchJob struct {
+	index   int
+	header  []byte
+	variant Variant
+	out     [][]byte
+	done    *sync.WaitGroup
+}
+
+// NewHasher starts a Hasher with one worker per GOMAXPROCS, each backed
+// by its own VH.NewVerushash() instance.
+func NewHasher() *Hasher {
+	workers := runtime.GOMAXPROCS(0)
+	if workers < 1 {
+		workers = 1
+	}
+	h := &Hasher{
+		jobs: make(chan batchJob),
+	}
+	h.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go h.worker()
+	}
+	return h
+}
+
+func (h *Hasher) worker() {
+	defer h.wg.Done()
+	vh := VH.NewVerushash()
+	for job := range h.jobs {
+		hash := make([]byte, 32)
+		ptrHash := uintptr(unsafe.Pointer(&hash[0]))
+		switch job.variant {
+		case V2B:
+			vh.Verushash_v2b(string(job.header), len(job.header), ptrHash)
+		case V2B1:
+			vh.Verushash_v2b1(string(job.header), len(job.header), ptrHash)
+		case V2B2:
+			vh.Verushash_v2b2(string(job.header), ptrHash)
+		default:
+			vh.Verushash(string(job.header), len(job.header), ptrHash)
+		}
+		job.out[job.index] = hash
+		job.done.Done()
+	}
+}
+
+// Close shuts down the worker pool. A Hasher must not be used after Close.
+func (h *Hasher) Close() {
+	close(h.jobs)
+	h.wg.Wait()
+}
+
+// Hash dispatches headers to the worker pool and returns their digests in
+// the same order as the input slice. It blocks until every worker has
+// written its result into out, not merely accepted its job: done is
+// only marked once a worker finishes the hash and the write, so the
+// returned slice is always fully populated.
+func (h *Hasher) Hash(headers [][]byte, variant Variant) [][]byte {
+	out := make([][]byte, len(headers))
+	var done sync.WaitGroup
+	done.Add(len(headers))
+	for i, header := range headers {
+		h.jobs <- batchJob{index: i, header: header, variant: variant, out: out, done: &done}
+	}
+	done.Wait()
+	return out
+}
+
+// BatchVerusHash hashes headers concurrently across a short-lived worker
+// pool sized to GOMAXPROCS, one VH.Verushash instance per worker. It is
+// the preferred entry point for mining and validation callers that need
+// to hash thousands of candidate headers (nonce sweeps, mempool
+// validation) without serializing through the package-level VerusHash
+// functions.
+func BatchVerusHash(headers [][]byte, variant Variant) [][]byte {
+	h := NewHasher()
+	defer h.Close()
+	return h.Hash(headers, variant)
+}
@@ -0,0 +1,56 @@
+// +build purego
+
+package verushash
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Hasher fans work out across goroutines bounded by GOMAXPROCS. Unlike
+// the cgo build, the purego hashing functions touch no shared C state,
+// so there is no per-worker instance to own — a bounded semaphore is
+// enough to cap concurrency.
+type Hasher struct {
+	sem chan struct{}
+}
+
+// NewHasher returns a Hasher that allows up to GOMAXPROCS concurrent
+// hashes in flight.
+func NewHasher() *Hasher {
+	workers := runtime.GOMAXPROCS(0)
+	if workers < 1 {
+		workers = 1
+	}
+	return &Hasher{sem: make(chan struct{}, workers)}
+}
+
+// Close is a no-op in the purego build; it exists so callers can write
+// build-tag-independent code against Hasher.
+func (h *Hasher) Close() {}
+
+// Hash dispatches headers across goroutines and returns their digests in
+// the same order as the input slice.
+func (h *Hasher) Hash(headers [][]byte, variant Variant) [][]byte {
+	out := make([][]byte, len(headers))
+	var wg sync.WaitGroup
+	wg.Add(len(headers))
+	for i, header := range headers {
+		h.sem <- struct{}{}
+		go func(i int, header []byte) {
+			defer wg.Done()
+			defer func() { <-h.sem }()
+			out[i] = hashFor(variant, header)
+		}(i, header)
+	}
+	wg.Wait()
+	return out
+}
+
+// BatchVerusHash hashes headers concurrently, bounded to GOMAXPROCS
+// goroutines in flight, mirroring the cgo build's API.
+func BatchVerusHash(headers [][]byte, variant Variant) [][]byte {
+	h := NewHasher()
+	defer h.Close()
+	return h.Hash(headers, variant)
+}
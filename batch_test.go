@@ -0,0 +1,74 @@
+// +build !purego
+
+package verushash
+
+import (
+	"bytes"
+	"testing"
+)
+
+// testHeaderSize doesn't need to match any real Verus header length —
+// these tests only check that batch hashing agrees with serial hashing
+// for whatever bytes VerusHash_V2B2 is given.
+const testHeaderSize = 1487
+
+func testHeaders(n int) [][]byte {
+	headers := make([][]byte, n)
+	for i := range headers {
+		header := make([]byte, testHeaderSize)
+		for j := range header {
+			header[j] = byte((i*31 + j) % 256)
+		}
+		headers[i] = header
+	}
+	return headers
+}
+
+func TestBatchVerusHashMatchesSerial(t *testing.T) {
+	headers := testHeaders(16)
+
+	got := BatchVerusHash(headers, V2B2)
+	for i, header := range headers {
+		want := VerusHash_V2B2(header)
+		if !bytes.Equal(got[i], want) {
+			t.Fatalf("header %d: batch result %x != serial result %x", i, got[i], want)
+		}
+	}
+}
+
+func TestHasherReusableAcrossCalls(t *testing.T) {
+	h := NewHasher()
+	defer h.Close()
+
+	headers := testHeaders(8)
+	first := h.Hash(headers, V2B2)
+	second := h.Hash(headers, V2B2)
+	for i := range headers {
+		if !bytes.Equal(first[i], second[i]) {
+			t.Fatalf("header %d: repeated Hash calls disagree: %x != %x", i, first[i], second[i])
+		}
+	}
+}
+
+func BenchmarkVerusHashV2B2Serial(b *testing.B) {
+	headers := testHeaders(b.N)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerusHash_V2B2(headers[i])
+	}
+}
+
+func BenchmarkBatchVerusHashV2B2(b *testing.B) {
+	headers := testHeaders(b.N)
+	b.ResetTimer()
+	BatchVerusHash(headers, V2B2)
+}
+
+func BenchmarkHasherV2B2(b *testing.B) {
+	h := NewHasher()
+	defer h.Close()
+
+	headers := testHeaders(b.N)
+	b.ResetTimer()
+	h.Hash(headers, V2B2)
+}
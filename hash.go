@@ -0,0 +1,75 @@
+package verushash
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+// digestSize is the length in bytes of every VerusHash variant's output.
+const digestSize = 32
+
+// blockSize is reported for hash.Hash compliance only; VerusHash has no
+// natural block size since the whole input is digested in one cgo call.
+const blockSize = 1
+
+// Digest implements hash.Hash by buffering Write calls and invoking
+// the underlying C binding once, on Sum. This mirrors how callers already
+// use VerusHash/VerusHash_V2B* today, but lets VerusHash compose with
+// io.Copy, hash.Hash32/Hash64 wrappers, HMAC, and the rest of the
+// standard hashing ecosystem.
+type Digest struct {
+	variant Variant
+	buf     []byte
+}
+
+// New returns a new hash.Hash computing VerusHash (v1). The concrete
+// type also exposes Sum64, following the xxhash/maphash convention.
+func New() *Digest {
+	return &Digest{variant: V1}
+}
+
+// NewV2B returns a new hash.Hash computing VerusHash v2.0.
+func NewV2B() *Digest {
+	return &Digest{variant: V2B}
+}
+
+// NewV2B1 returns a new hash.Hash computing VerusHash v2.1.
+func NewV2B1() *Digest {
+	return &Digest{variant: V2B1}
+}
+
+// NewV2B2 returns a new hash.Hash computing VerusHash v2.2.
+func NewV2B2() *Digest {
+	return &Digest{variant: V2B2}
+}
+
+var _ hash.Hash = (*Digest)(nil)
+
+func (v *Digest) Write(p []byte) (n int, err error) {
+	v.buf = append(v.buf, p...)
+	return len(p), nil
+}
+
+func (v *Digest) Sum(b []byte) []byte {
+	digest := hashFor(v.variant, v.buf)
+	return append(b, digest...)
+}
+
+func (v *Digest) Reset() {
+	v.buf = v.buf[:0]
+}
+
+func (v *Digest) Size() int {
+	return digestSize
+}
+
+func (v *Digest) BlockSize() int {
+	return blockSize
+}
+
+// Sum64 returns the low 64 bits of the current digest, for use as a map
+// hash seed in the style of maphash.Hash.Sum64.
+func (v *Digest) Sum64() uint64 {
+	digest := hashFor(v.variant, v.buf)
+	return binary.LittleEndian.Uint64(digest[:8])
+}
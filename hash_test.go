@@ -0,0 +1,54 @@
+package verushash
+
+import "testing"
+
+func TestDigestConstructorsSetVariant(t *testing.T) {
+	cases := []struct {
+		name string
+		d    *Digest
+		want Variant
+	}{
+		{"New", New(), V1},
+		{"NewV2B", NewV2B(), V2B},
+		{"NewV2B1", NewV2B1(), V2B1},
+		{"NewV2B2", NewV2B2(), V2B2},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.d.variant != c.want {
+				t.Fatalf("%s variant = %v, want %v", c.name, c.d.variant, c.want)
+			}
+		})
+	}
+}
+
+func TestDigestWriteAccumulatesAndResetClears(t *testing.T) {
+	d := New()
+	d.Write([]byte("hello, "))
+	d.Write([]byte("verushash"))
+	if string(d.buf) != "hello, verushash" {
+		t.Fatalf("buf = %q, want %q", d.buf, "hello, verushash")
+	}
+
+	d.Reset()
+	if len(d.buf) != 0 {
+		t.Fatalf("buf after Reset = %q, want empty", d.buf)
+	}
+
+	// The backing array must still be usable after Reset, not just the
+	// length zeroed out from under a stale slice.
+	d.Write([]byte("again"))
+	if string(d.buf) != "again" {
+		t.Fatalf("buf after Reset+Write = %q, want %q", d.buf, "again")
+	}
+}
+
+func TestDigestSizeAndBlockSize(t *testing.T) {
+	d := New()
+	if got := d.Size(); got != digestSize {
+		t.Fatalf("Size() = %d, want %d", got, digestSize)
+	}
+	if got := d.BlockSize(); got != blockSize {
+		t.Fatalf("BlockSize() = %d, want %d", got, blockSize)
+	}
+}
@@ -0,0 +1,70 @@
+package verushash
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrCompactOverflow is returned by CheckPoWCompact when the exponent
+// byte of nBits is greater than 32, whatever the mantissa.
+var ErrCompactOverflow = errors.New("verushash: compact bits overflow 256-bit target")
+
+// CheckProofOfWork hashes header with the requested VerusHash variant,
+// interprets the digest as a little-endian 256-bit integer (matching
+// Verus/Bitcoin conventions), and reports whether it is less than or
+// equal to target. It also returns the digest so callers don't have to
+// hash the header again.
+func CheckProofOfWork(header []byte, target *big.Int, variant Variant) (bool, []byte, error) {
+	if target.Sign() <= 0 {
+		return false, nil, errors.New("verushash: target must be positive")
+	}
+	digest := hashFor(variant, header)
+	hashInt := littleEndianToBig(digest)
+	return hashInt.Cmp(target) <= 0, digest, nil
+}
+
+// CheckPoWCompact is CheckProofOfWork for callers that only have the
+// compact "nBits" encoding of the target, as stored in a block header.
+func CheckPoWCompact(header []byte, nBits uint32, variant Variant) (bool, []byte, error) {
+	target, err := compactToBig(nBits)
+	if err != nil {
+		return false, nil, err
+	}
+	return CheckProofOfWork(header, target, variant)
+}
+
+// littleEndianToBig interprets b as a little-endian unsigned integer, the
+// convention VerusHash digests and Bitcoin/Verus proof-of-work targets
+// use.
+func littleEndianToBig(b []byte) *big.Int {
+	reversed := make([]byte, len(b))
+	for i, v := range b {
+		reversed[len(b)-1-i] = v
+	}
+	return new(big.Int).SetBytes(reversed)
+}
+
+// compactToBig expands the Bitcoin/Verus compact "nBits" difficulty
+// encoding into a big.Int target: the top byte is the exponent (in
+// bytes) and the remaining three bytes are the mantissa.
+func compactToBig(nBits uint32) (*big.Int, error) {
+	exponent := uint(nBits >> 24)
+	mantissa := nBits & 0x007fffff
+
+	// The sign bit (0x00800000) is never legitimately set for a Verus
+	// proof-of-work target.
+	if nBits&0x00800000 != 0 {
+		return nil, errors.New("verushash: compact bits has sign bit set")
+	}
+	if exponent > 32 {
+		return nil, ErrCompactOverflow
+	}
+
+	target := new(big.Int).SetUint64(uint64(mantissa))
+	if exponent <= 3 {
+		target.Rsh(target, 8*(3-exponent))
+	} else {
+		target.Lsh(target, 8*(exponent-3))
+	}
+	return target, nil
+}
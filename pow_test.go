@@ -0,0 +1,72 @@
+package verushash
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestLittleEndianToBig(t *testing.T) {
+	cases := []struct {
+		name string
+		in   []byte
+		want *big.Int
+	}{
+		{"zero", []byte{0x00, 0x00}, big.NewInt(0)},
+		{"single byte", []byte{0x01}, big.NewInt(1)},
+		{"little-endian order", []byte{0x01, 0x00}, big.NewInt(1)},
+		{"two bytes", []byte{0x00, 0x01}, big.NewInt(256)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := littleEndianToBig(c.in)
+			if got.Cmp(c.want) != 0 {
+				t.Fatalf("littleEndianToBig(%x) = %s, want %s", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestCompactToBig(t *testing.T) {
+	cases := []struct {
+		name      string
+		nBits     uint32
+		want      *big.Int
+		wantErr   bool
+		wantExact error
+	}{
+		{"zero mantissa", 0x00000000, big.NewInt(0), false, nil},
+		{"exponent below 3 shifts right", 0x02008000, big.NewInt(0x80), false, nil},
+		{"exponent 3 is mantissa verbatim", 0x03123456, big.NewInt(0x123456), false, nil},
+		{"exponent above 3 shifts left", 0x04123456, big.NewInt(0x12345600), false, nil},
+		{"sign bit set is rejected", 0x02800000, nil, true, nil},
+		{"exponent overflow is rejected", 0x21000001, nil, true, ErrCompactOverflow},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := compactToBig(c.nBits)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("compactToBig(%#x) returned nil error, want one", c.nBits)
+				}
+				if c.wantExact != nil && err != c.wantExact {
+					t.Fatalf("compactToBig(%#x) error = %v, want %v", c.nBits, err, c.wantExact)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("compactToBig(%#x) returned unexpected error: %v", c.nBits, err)
+			}
+			if got.Cmp(c.want) != 0 {
+				t.Fatalf("compactToBig(%#x) = %s, want %s", c.nBits, got, c.want)
+			}
+		})
+	}
+}
+
+func TestCheckPoWCompactRejectsOverflow(t *testing.T) {
+	header := make([]byte, solutionVersionOffset+solutionVersionLength)
+	_, _, err := CheckPoWCompact(header, 0x21000001, V1)
+	if err != ErrCompactOverflow {
+		t.Fatalf("CheckPoWCompact with overflowing nBits = %v, want ErrCompactOverflow", err)
+	}
+}
@@ -0,0 +1,47 @@
+// +build purego
+
+// Package verushash, built with -tags purego, builds without the
+// cgo/SWIG wrapper around the C++ verushash library, for environments
+// where cgo is unavailable or undesirable (cross-compiling, WASM,
+// restricted build systems).
+//
+// None of the VerusHash variants are implemented in Go yet: shipping a
+// function under the VerusHash_V2B2/VerusHashAuto names that silently
+// computed a different digest than the real cgo-backed algorithm would
+// be worse than not having a purego build at all, since callers doing
+// consensus or proof-of-work validation would validate against the
+// wrong hash with no error. A from-scratch Haraka-512 + VerusCLHash port
+// needs to match the upstream C++ implementation bit-for-bit before it
+// can replace these panics; that is tracked as follow-up work.
+package verushash
+
+import "fmt"
+
+func notImplementedInPureGo(variant Variant) []byte {
+	panic(fmt.Sprintf("verushash: %s is not implemented in the purego build; build without -tags purego", variant))
+}
+
+// VerusHash computes VerusHash v1. Not yet available in the purego
+// build; use the default (cgo) build tag.
+func VerusHash(serializedHeader []byte) []byte {
+	return notImplementedInPureGo(V1)
+}
+
+// VerusHash_V2B computes VerusHash v2.0. Not yet available in the purego
+// build; use the default (cgo) build tag.
+func VerusHash_V2B(serializedHeader []byte) []byte {
+	return notImplementedInPureGo(V2B)
+}
+
+// VerusHash_V2B1 computes VerusHash v2.1. Not yet available in the
+// purego build; use the default (cgo) build tag.
+func VerusHash_V2B1(serializedHeader []byte) []byte {
+	return notImplementedInPureGo(V2B1)
+}
+
+// VerusHash_V2B2 computes VerusHash v2.2. Not yet available in the
+// purego build; use the default (cgo) build tag. See the package doc
+// comment: a wrong-but-silent digest here would be worse than a panic.
+func VerusHash_V2B2(serializedHeader []byte) []byte {
+	return notImplementedInPureGo(V2B2)
+}
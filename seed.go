@@ -0,0 +1,68 @@
+package verushash
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+)
+
+// Seed is keying material for SumSeeded and DeriveKey, following the
+// maphash.Seed model: an opaque value callers generate with MakeSeed and
+// reuse across calls to get a domain-separated VerusHash-based PRF
+// instead of misusing the raw block-hashing API for non-consensus uses.
+type Seed [32]byte
+
+// MakeSeed returns a new random Seed suitable for SumSeeded/DeriveKey.
+func MakeSeed() Seed {
+	var s Seed
+	if _, err := rand.Read(s[:]); err != nil {
+		panic("verushash: failed to read random seed: " + err.Error())
+	}
+	return s
+}
+
+// seededBlockSize is the fixed block layout SumSeeded pads/truncates its
+// seed+data input to. It is not tied to any particular Verus header
+// format — SumSeeded is a non-consensus primitive — it just needs to be
+// a single fixed size so the same (seed, data) always hashes the same
+// combined layout regardless of data's length.
+const seededBlockSize = 1344
+
+// SumSeeded hashes seed prepended to data with the requested VerusHash
+// variant, padding or truncating the combined input to seededBlockSize.
+// The result is a domain-separated, PRF-like digest: two calls with
+// different seeds over the same data are unrelated, unlike plain
+// VerusHash of data alone.
+func SumSeeded(seed Seed, data []byte, variant Variant) [32]byte {
+	block := make([]byte, seededBlockSize)
+	n := copy(block, seed[:])
+	copy(block[n:], data)
+
+	var out [32]byte
+	copy(out[:], hashFor(variant, block))
+	return out
+}
+
+// DeriveKey fills out with keystream derived from seed and info by
+// iterating SumSeeded in counter mode, for non-consensus uses such as
+// cache sharding, rate-limit keys, or Merkle commitments where today
+// callers have to misuse the block-hashing API to get variable-length
+// output.
+//
+// The counter is placed before info, not after it: SumSeeded truncates
+// data past seededBlockSize, and info's length is up to the caller, so
+// a counter appended after it could be truncated away entirely, making
+// every iteration hash the same bytes. Leading with the counter keeps it
+// inside the untruncated prefix regardless of how long info is.
+func DeriveKey(seed Seed, info []byte, out []byte) {
+	var counter uint64
+	for len(out) > 0 {
+		var counterBytes [8]byte
+		binary.LittleEndian.PutUint64(counterBytes[:], counter)
+		block := append(append([]byte{}, counterBytes[:]...), info...)
+		digest := SumSeeded(seed, block, V2B2)
+
+		n := copy(out, digest[:])
+		out = out[n:]
+		counter++
+	}
+}
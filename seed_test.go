@@ -0,0 +1,78 @@
+// +build !purego
+
+package verushash
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSumSeededDifferentSeedsDiverge(t *testing.T) {
+	data := []byte("hello, verushash")
+	var a, b Seed
+	for i := range a {
+		a[i] = byte(i)
+		b[i] = byte(i + 1)
+	}
+
+	da := SumSeeded(a, data, V2B2)
+	db := SumSeeded(b, data, V2B2)
+	if da == db {
+		t.Fatalf("SumSeeded with different seeds produced the same digest")
+	}
+}
+
+func TestSumSeededRespectsTruncationBoundary(t *testing.T) {
+	var seed Seed
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+
+	data := bytes.Repeat([]byte{0xAB}, seededBlockSize*2)
+	kept := seededBlockSize - len(seed)
+
+	before := SumSeeded(seed, data, V2B2)
+
+	beyond := append([]byte{}, data...)
+	beyond[kept] ^= 0xFF // first byte past the kept prefix
+	after := SumSeeded(seed, beyond, V2B2)
+	if before != after {
+		t.Fatalf("changing a byte past the truncation boundary changed the digest")
+	}
+
+	within := append([]byte{}, data...)
+	within[kept-1] ^= 0xFF // last byte inside the kept prefix
+	changed := SumSeeded(seed, within, V2B2)
+	if before == changed {
+		t.Fatalf("changing a byte inside the truncation boundary did not change the digest")
+	}
+}
+
+func TestDeriveKeyCounterSurvivesLongInfo(t *testing.T) {
+	seed := MakeSeed()
+	info := bytes.Repeat([]byte{0x01}, seededBlockSize*2)
+
+	out := make([]byte, 64)
+	DeriveKey(seed, info, out)
+
+	if bytes.Equal(out[:32], out[32:]) {
+		t.Fatalf("DeriveKey produced identical blocks; the counter was truncated away by long info")
+	}
+}
+
+func TestDeriveKeyFillsRequestedLength(t *testing.T) {
+	seed := MakeSeed()
+	out := make([]byte, 50)
+	DeriveKey(seed, []byte("info"), out)
+
+	allZero := true
+	for _, b := range out {
+		if b != 0 {
+			allZero = false
+			break
+		}
+	}
+	if allZero {
+		t.Fatalf("DeriveKey produced all-zero output")
+	}
+}
@@ -0,0 +1,47 @@
+package verushash
+
+// Variant identifies which VerusHash algorithm revision to use. The
+// revisions correspond 1:1 with the Verus consensus solution versions
+// and with the underlying VH.Verushash* C bindings.
+type Variant int
+
+const (
+	// V1 is the original VerusHash algorithm (solution version 1).
+	V1 Variant = iota
+	// V2B is VerusHash v2.0 (solution version 2.0).
+	V2B
+	// V2B1 is VerusHash v2.1 (solution version 2.1).
+	V2B1
+	// V2B2 is VerusHash v2.2 (solution version 2.2+).
+	V2B2
+)
+
+// String returns a human-readable name for the variant.
+func (v Variant) String() string {
+	switch v {
+	case V1:
+		return "V1"
+	case V2B:
+		return "V2B"
+	case V2B1:
+		return "V2B1"
+	case V2B2:
+		return "V2B2"
+	default:
+		return "unknown"
+	}
+}
+
+// hashFor dispatches to the one-shot hashing function for the variant.
+func hashFor(variant Variant, serializedHeader []byte) []byte {
+	switch variant {
+	case V2B:
+		return VerusHash_V2B(serializedHeader)
+	case V2B1:
+		return VerusHash_V2B1(serializedHeader)
+	case V2B2:
+		return VerusHash_V2B2(serializedHeader)
+	default:
+		return VerusHash(serializedHeader)
+	}
+}